@@ -0,0 +1,16 @@
+package randtool
+
+// Preset alphabets for GenStrWithAlphabet and GenStrSecureWithAlphabet.
+const (
+	// AlphabetAlphanumeric is the 62-character set of digits and upper/lower case letters.
+	AlphabetAlphanumeric = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// AlphabetURLSafe is safe to embed in a URL path or query segment without escaping.
+	AlphabetURLSafe = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+	// AlphabetHex is lowercase hexadecimal.
+	AlphabetHex = "0123456789abcdef"
+	// AlphabetBase32Crockford is Crockford's base32, which excludes I, L, O and U to avoid
+	// confusion with 1 and 0 when read aloud or transcribed by hand.
+	AlphabetBase32Crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// AlphabetDigits is the 10 decimal digits.
+	AlphabetDigits = "0123456789"
+)
@@ -0,0 +1,52 @@
+package randtool
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+// GenBytes returns n cryptographically random bytes read from crypto/rand.Reader.
+func GenBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if err := GenBytesInto(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// GenBytesInto fills dst with cryptographically random bytes read from
+// crypto/rand.Reader, without allocating a new slice.
+func GenBytesInto(dst []byte) error {
+	if _, err := io.ReadFull(rand.Reader, dst); err != nil {
+		return fmt.Errorf("randtool: could not read crypto/rand: %w", err)
+	}
+	return nil
+}
+
+// Reader returns an io.Reader that streams cryptographically random bytes
+// directly from crypto/rand.Reader.
+func Reader() io.Reader {
+	return rand.Reader
+}
+
+// GenHex returns a hex-encoded string of n cryptographically random bytes.
+func GenHex(n int) string {
+	b, err := GenBytes(n)
+	if err != nil {
+		panic(fmt.Sprintf("Can not read crypto/rand lib: %s", err.Error()))
+	}
+	return hex.EncodeToString(b)
+}
+
+// GenBase64URL returns a URL-safe, unpadded base64 encoding of n
+// cryptographically random bytes.
+func GenBase64URL(n int) string {
+	b, err := GenBytes(n)
+	if err != nil {
+		panic(fmt.Sprintf("Can not read crypto/rand lib: %s", err.Error()))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
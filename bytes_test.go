@@ -0,0 +1,66 @@
+package randtool
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"testing"
+)
+
+func TestGenBytesLength(t *testing.T) {
+	b, err := GenBytes(16)
+	if err != nil {
+		t.Fatalf("GenBytes: %v", err)
+	}
+	if len(b) != 16 {
+		t.Fatalf("GenBytes(16) returned %d bytes, want 16", len(b))
+	}
+}
+
+func TestGenBytesIntoFillsExactLength(t *testing.T) {
+	dst := make([]byte, 32)
+	if err := GenBytesInto(dst); err != nil {
+		t.Fatalf("GenBytesInto: %v", err)
+	}
+	if len(dst) != 32 {
+		t.Fatalf("GenBytesInto left dst with length %d, want 32", len(dst))
+	}
+
+	var zero [32]byte
+	if string(dst) == string(zero[:]) {
+		t.Fatal("GenBytesInto left dst all zero; expected it to be filled with random bytes")
+	}
+}
+
+func TestGenHexLengthAndAlphabet(t *testing.T) {
+	s := GenHex(8)
+	if len(s) != 16 { // 8 bytes hex-encode to 16 characters
+		t.Fatalf("GenHex(8) length = %d, want 16", len(s))
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		t.Fatalf("GenHex(8) = %q is not valid hex: %v", s, err)
+	}
+}
+
+func TestGenBase64URLDecodes(t *testing.T) {
+	s := GenBase64URL(12)
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatalf("GenBase64URL(12) = %q is not valid URL-safe base64: %v", s, err)
+	}
+	if len(b) != 12 {
+		t.Fatalf("GenBase64URL(12) decoded to %d bytes, want 12", len(b))
+	}
+}
+
+func TestReaderYieldsBytes(t *testing.T) {
+	buf := make([]byte, 16)
+	if _, err := io.ReadFull(Reader(), buf); err != nil {
+		t.Fatalf("io.ReadFull(Reader(), buf): %v", err)
+	}
+
+	var zero [16]byte
+	if string(buf) == string(zero[:]) {
+		t.Fatal("Reader() yielded all-zero bytes; expected randomness")
+	}
+}
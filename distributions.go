@@ -0,0 +1,168 @@
+package randtool
+
+import "errors"
+
+// IntRangeE is IntRange but returns an error instead of panicking when the
+// range is empty or inverted (max <= min).
+func (r *Rand) IntRangeE(min, max int) (int, error) {
+	if max <= min {
+		return 0, errors.New("randtool: invalid range, max must be greater than min")
+	}
+	return r.IntRange(min, max), nil
+}
+
+// Float64 returns a pseudo-random float64 in the half-open interval [0.0, 1.0).
+func (r *Rand) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.src.Float64()
+}
+
+// Float64Range returns a pseudo-random float64 in the half-open interval [min, max).
+func (r *Rand) Float64Range(min, max float64) float64 {
+	return min + r.Float64()*(max-min)
+}
+
+// Normal returns a pseudo-random float64 drawn from a normal distribution
+// with the given mean and standard deviation.
+func (r *Rand) Normal(mean, stddev float64) float64 {
+	r.mu.Lock()
+	v := r.src.NormFloat64()
+	r.mu.Unlock()
+	return mean + v*stddev
+}
+
+// Exponential returns a pseudo-random float64 drawn from an exponential
+// distribution with the given rate (lambda).
+func (r *Rand) Exponential(rate float64) float64 {
+	r.mu.Lock()
+	v := r.src.ExpFloat64()
+	r.mu.Unlock()
+	return v / rate
+}
+
+// WeightedIndex builds a one-shot AliasTable for weights and draws a single
+// index from it. Callers making many draws from the same distribution
+// should build an AliasTable once via NewAliasTable and call its Draw method
+// directly to avoid repeating the O(n) setup.
+func (r *Rand) WeightedIndex(weights []float64) (int, error) {
+	t, err := NewAliasTable(weights)
+	if err != nil {
+		return 0, err
+	}
+	return t.Draw(r), nil
+}
+
+// AliasTable implements Walker's alias method for weighted sampling: an
+// O(n) setup (NewAliasTable) followed by O(1) draws (Draw), so repeatedly
+// sampling the same distribution doesn't keep paying the setup cost.
+type AliasTable struct {
+	prob  []float64
+	alias []int
+}
+
+// NewAliasTable builds an AliasTable for the given weights. Weights need not
+// sum to 1; they are normalized internally. At least one weight must be
+// positive and none may be negative.
+func NewAliasTable(weights []float64) (*AliasTable, error) {
+	n := len(weights)
+	if n == 0 {
+		return nil, errors.New("randtool: weights must not be empty")
+	}
+
+	var total float64
+	for _, w := range weights {
+		if w < 0 {
+			return nil, errors.New("randtool: weights must not be negative")
+		}
+		total += w
+	}
+	if total <= 0 {
+		return nil, errors.New("randtool: weights must contain at least one positive value")
+	}
+
+	prob := make([]float64, n)
+	alias := make([]int, n)
+	scaled := make([]float64, n)
+
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, w := range weights {
+		scaled[i] = w / total * float64(n)
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		s := small[len(small)-1]
+		small = small[:len(small)-1]
+		l := large[len(large)-1]
+		large = large[:len(large)-1]
+
+		prob[s] = scaled[s]
+		alias[s] = l
+
+		scaled[l] = scaled[l] + scaled[s] - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+
+	// Leftover entries accumulated floating point error rather than a real
+	// rejection; treat them as certain.
+	for _, i := range large {
+		prob[i] = 1
+	}
+	for _, i := range small {
+		prob[i] = 1
+	}
+
+	return &AliasTable{prob: prob, alias: alias}, nil
+}
+
+// Draw returns a random index in [0, n) in O(1), where n is the number of
+// weights the table was built with, with each index drawn proportionally to
+// its weight.
+func (t *AliasTable) Draw(r *Rand) int {
+	i := r.IntRange(0, len(t.prob))
+	if r.Float64() < t.prob[i] {
+		return i
+	}
+	return t.alias[i]
+}
+
+// GenIntRangeE is GenIntRange but returns an error instead of panicking when
+// the range is empty or inverted (max <= min).
+func GenIntRangeE(min, max int) (int, error) {
+	return defaultRand.IntRangeE(min, max)
+}
+
+// GenFloat64Range generates a random float64 in the half-open interval [min, max).
+func GenFloat64Range(min, max float64) float64 {
+	return defaultRand.Float64Range(min, max)
+}
+
+// GenNormal generates a random float64 drawn from a normal distribution with
+// the given mean and standard deviation.
+func GenNormal(mean, stddev float64) float64 {
+	return defaultRand.Normal(mean, stddev)
+}
+
+// GenExponential generates a random float64 drawn from an exponential
+// distribution with the given rate (lambda).
+func GenExponential(rate float64) float64 {
+	return defaultRand.Exponential(rate)
+}
+
+// GenWeightedIndex draws a single index from weights, with each index drawn
+// proportionally to its weight. Callers making repeated draws from the same
+// distribution should use NewAliasTable instead to avoid rebuilding the
+// table on every call.
+func GenWeightedIndex(weights []float64) (int, error) {
+	return defaultRand.WeightedIndex(weights)
+}
@@ -0,0 +1,140 @@
+package randtool
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGenIntRangeEInvalidRange(t *testing.T) {
+	if _, err := GenIntRangeE(5, 5); err == nil {
+		t.Fatal("expected an error for an empty range, got nil")
+	}
+	if _, err := GenIntRangeE(5, 3); err == nil {
+		t.Fatal("expected an error for an inverted range, got nil")
+	}
+}
+
+func TestGenIntRangeEValidRange(t *testing.T) {
+	v, err := GenIntRangeE(0, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v < 0 || v >= 10 {
+		t.Fatalf("value %d out of range [0, 10)", v)
+	}
+}
+
+func TestNewAliasTableRejectsInvalidWeights(t *testing.T) {
+	cases := [][]float64{
+		{},
+		{-1, 2},
+		{0, 0},
+	}
+	for _, weights := range cases {
+		if _, err := NewAliasTable(weights); err == nil {
+			t.Fatalf("expected an error for weights %v, got nil", weights)
+		}
+	}
+}
+
+func TestAliasTableDrawDistribution(t *testing.T) {
+	SetTestSeed(t, 1)
+
+	table, err := NewAliasTable([]float64{1, 0, 3})
+	if err != nil {
+		t.Fatalf("NewAliasTable: %v", err)
+	}
+
+	const draws = 20000
+	counts := make([]int, 3)
+	for i := 0; i < draws; i++ {
+		idx := table.Draw(defaultRand)
+		if idx < 0 || idx >= 3 {
+			t.Fatalf("Draw returned out-of-range index %d", idx)
+		}
+		counts[idx]++
+	}
+
+	if counts[1] != 0 {
+		t.Fatalf("zero-weight index 1 was drawn %d times, want 0", counts[1])
+	}
+
+	// Weights are 1:0:3, so index 2 should be drawn roughly 3x as often as
+	// index 0. Allow generous slack since this is a statistical test.
+	ratio := float64(counts[2]) / float64(counts[0])
+	if ratio < 2 || ratio > 4 {
+		t.Fatalf("counts[2]/counts[0] = %.2f, want roughly 3", ratio)
+	}
+}
+
+func TestGenWeightedIndexRespectsWeights(t *testing.T) {
+	idx, err := GenWeightedIndex([]float64{0, 1})
+	if err != nil {
+		t.Fatalf("GenWeightedIndex: %v", err)
+	}
+	if idx != 1 {
+		t.Fatalf("GenWeightedIndex with weights [0, 1] returned %d, want 1", idx)
+	}
+}
+
+func TestGenFloat64RangeBounds(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		v := GenFloat64Range(-5, 5)
+		if v < -5 || v >= 5 {
+			t.Fatalf("GenFloat64Range(-5, 5) = %v, out of [-5, 5)", v)
+		}
+	}
+}
+
+func TestGenNormalSanity(t *testing.T) {
+	SetTestSeed(t, 1)
+
+	const (
+		mean   = 10.0
+		stddev = 2.0
+		draws  = 20000
+	)
+
+	var sum, sumSq float64
+	for i := 0; i < draws; i++ {
+		v := GenNormal(mean, stddev)
+		sum += v
+		sumSq += v * v
+	}
+
+	sampleMean := sum / draws
+	sampleVar := sumSq/draws - sampleMean*sampleMean
+	sampleStddev := math.Sqrt(sampleVar)
+
+	if math.Abs(sampleMean-mean) > 0.2 {
+		t.Fatalf("sample mean = %v, want close to %v", sampleMean, mean)
+	}
+	if math.Abs(sampleStddev-stddev) > 0.2 {
+		t.Fatalf("sample stddev = %v, want close to %v", sampleStddev, stddev)
+	}
+}
+
+func TestGenExponentialSanity(t *testing.T) {
+	SetTestSeed(t, 1)
+
+	const (
+		rate  = 2.0
+		draws = 20000
+	)
+
+	var sum float64
+	for i := 0; i < draws; i++ {
+		v := GenExponential(rate)
+		if v < 0 {
+			t.Fatalf("GenExponential(%v) = %v, want >= 0", rate, v)
+		}
+		sum += v
+	}
+
+	// The mean of an exponential distribution with the given rate is 1/rate.
+	sampleMean := sum / draws
+	want := 1 / rate
+	if math.Abs(sampleMean-want) > 0.1 {
+		t.Fatalf("sample mean = %v, want close to %v", sampleMean, want)
+	}
+}
@@ -0,0 +1,108 @@
+package randtool
+
+import (
+	"errors"
+	mathrand "math/rand"
+	"sync"
+	"time"
+)
+
+// Available chars for Rand.Str()
+const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// Rand is an independently seeded source of pseudo random values. Unlike the
+// package-level Gen* helpers, which share a single default instance behind a
+// mutex, each Rand owns its own math/rand source, so heavy concurrent use
+// doesn't serialize unrelated callers against one another.
+type Rand struct {
+	mu  sync.Mutex
+	src *mathrand.Rand
+}
+
+// New returns a Rand seeded from crypto/rand.
+func New() *Rand {
+	return NewFromSeed(GenInt64() + time.Now().UnixNano())
+}
+
+// NewFromSeed returns a Rand deterministically seeded from seed.
+func NewFromSeed(seed int64) *Rand {
+	return &Rand{src: mathrand.New(mathrand.NewSource(seed))}
+}
+
+// int63 returns a pseudo-random int64 in the range [0, 1<<63).
+func (r *Rand) int63() int64 {
+	r.mu.Lock()
+	v := r.src.Int63()
+	r.mu.Unlock()
+	return v
+}
+
+// Int64 returns a pseudo-random int64 in the range [0, 1<<63).
+func (r *Rand) Int64() int64 {
+	return r.int63()
+}
+
+// IntRange generates a random int within the specified range.
+func (r *Rand) IntRange(min, max int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.src.Intn(max-min) + min
+}
+
+// Str generates a url safe pseudo random alphabetic string of N length.
+// Credits goes to (icza) http://stackoverflow.com/a/31832326/5315198
+func (r *Rand) Str(n int) (string, error) {
+	return r.StrWithAlphabet(n, chars)
+}
+
+// StrIgnoreErr returns the value of Str with the error ignored.
+// Use with caution
+func (r *Rand) StrIgnoreErr(n int) string {
+	s, _ := r.Str(n)
+	return s
+}
+
+// StrWithAlphabet generates a pseudo random string of N length drawn from
+// the given alphabet. See the Alphabet* constants for common presets.
+func (r *Rand) StrWithAlphabet(n int, alphabet string) (string, error) {
+	if n < 1 {
+		return "", errors.New("randtool: random string length must be greater than 0")
+	}
+	if len(alphabet) < 2 {
+		return "", errors.New("randtool: alphabet must contain at least 2 characters")
+	}
+
+	idxBits := bitsNeeded(len(alphabet))
+	idxMask := int64(1<<idxBits - 1)
+	idxMax := 63 / int(idxBits)
+
+	b := make([]byte, n)
+	for i, cache, remain := n-1, r.int63(), idxMax; i >= 0; {
+		if remain == 0 {
+			cache, remain = r.int63(), idxMax
+		}
+		if idx := int(cache & idxMask); idx < len(alphabet) {
+			b[i] = alphabet[idx]
+			i--
+		}
+		cache >>= idxBits
+		remain--
+	}
+
+	return string(b), nil
+}
+
+// bytes returns n pseudo-random bytes drawn from r's own source. Unlike the
+// package-level GenBytes, which always reads from crypto/rand, this can be
+// made fully reproducible via NewFromSeed or NewDeterministic.
+func (r *Rand) bytes(n int) []byte {
+	b := make([]byte, n)
+	r.mu.Lock()
+	r.src.Read(b) // math/rand.Rand.Read never returns an error.
+	r.mu.Unlock()
+	return b
+}
+
+// defaultRand backs the package-level Gen* helpers, kept for backward
+// compatibility with callers that don't need their own Rand instance.
+var defaultRand = New()
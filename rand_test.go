@@ -0,0 +1,46 @@
+package randtool
+
+import "testing"
+
+func TestStrWithAlphabetOddLengths(t *testing.T) {
+	for _, n := range []int{3, 5, 33} {
+		alphabet := AlphabetAlphanumeric[:n]
+
+		s, err := GenStrWithAlphabet(64, alphabet)
+		if err != nil {
+			t.Fatalf("GenStrWithAlphabet(64, len=%d): %v", n, err)
+		}
+		if len(s) != 64 {
+			t.Fatalf("GenStrWithAlphabet(64, len=%d) returned length %d", n, len(s))
+		}
+		if !isSubsetOf(s, alphabet) {
+			t.Fatalf("GenStrWithAlphabet(64, len=%d) = %q contains a character outside the alphabet %q", n, s, alphabet)
+		}
+	}
+}
+
+func TestStrWithAlphabetRejectsShortInputs(t *testing.T) {
+	if _, err := GenStrWithAlphabet(0, AlphabetAlphanumeric); err == nil {
+		t.Fatal("expected an error for n < 1, got nil")
+	}
+	if _, err := GenStrWithAlphabet(5, "x"); err == nil {
+		t.Fatal("expected an error for a single-character alphabet, got nil")
+	}
+}
+
+func TestGenStrSecureWithAlphabetOddLengths(t *testing.T) {
+	for _, n := range []int{3, 5, 33} {
+		alphabet := AlphabetAlphanumeric[:n]
+
+		s, err := GenStrSecureWithAlphabet(64, alphabet)
+		if err != nil {
+			t.Fatalf("GenStrSecureWithAlphabet(64, len=%d): %v", n, err)
+		}
+		if len(s) != 64 {
+			t.Fatalf("GenStrSecureWithAlphabet(64, len=%d) returned length %d", n, len(s))
+		}
+		if !isSubsetOf(s, alphabet) {
+			t.Fatalf("GenStrSecureWithAlphabet(64, len=%d) = %q contains a character outside the alphabet %q", n, s, alphabet)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package randtool
+
+import (
+	"bufio"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sync"
+)
+
+// secureBufSize is how many bytes are pulled from crypto/rand.Reader at a time,
+// to amortize the cost of the underlying syscall across many draws.
+const secureBufSize = 1024
+
+var (
+	secureMu  sync.Mutex
+	secureBuf = bufio.NewReaderSize(rand.Reader, secureBufSize)
+)
+
+// secureByte returns a single cryptographically random byte, refilling the
+// buffered crypto/rand reader in bulk as needed.
+func secureByte() byte {
+	secureMu.Lock()
+	defer secureMu.Unlock()
+
+	b, err := secureBuf.ReadByte()
+	if err != nil {
+		panic(fmt.Sprintf("Can not read crypto/rand lib: %s", err.Error()))
+	}
+	return b
+}
+
+// secureUint returns a cryptographically random unsigned integer built from
+// nBytes random bytes, most significant byte first.
+func secureUint(nBytes int) uint64 {
+	var v uint64
+	for i := 0; i < nBytes; i++ {
+		v = v<<8 | uint64(secureByte())
+	}
+	return v
+}
+
+// bitsNeeded returns the number of bits required to index into n distinct
+// values, i.e. ceil(log2(n)).
+func bitsNeeded(n int) uint {
+	if n <= 1 {
+		return 1
+	}
+	return uint(bits.Len(uint(n - 1)))
+}
+
+// GenStrWithAlphabet generates a pseudo random string of N length drawn from
+// the given alphabet, using the same seeded math/rand source as GenStr. See
+// the Alphabet* constants for common presets.
+func GenStrWithAlphabet(n int, alphabet string) (string, error) {
+	return defaultRand.StrWithAlphabet(n, alphabet)
+}
+
+// GenStrSecure generates a cryptographically secure random alphanumeric
+// string of N length, drawing bytes from crypto/rand.Reader instead of the
+// seeded math/rand path used by GenStr. Prefer this for tokens, API keys or
+// anything else that must not be guessable from the pseudo-random stream.
+func GenStrSecure(n int) (string, error) {
+	return GenStrSecureWithAlphabet(n, AlphabetAlphanumeric)
+}
+
+// GenStrSecureWithAlphabet is GenStrSecure with a caller-supplied alphabet.
+// It rejects out-of-range draws (bitmask-and-reject) rather than using a
+// modulo, so every character of the alphabet remains equally likely.
+func GenStrSecureWithAlphabet(n int, alphabet string) (string, error) {
+	if n < 1 {
+		return "", errors.New("randtool: random string length must be greater than 0")
+	}
+	if len(alphabet) < 2 {
+		return "", errors.New("randtool: alphabet must contain at least 2 characters")
+	}
+
+	idxBits := bitsNeeded(len(alphabet))
+	idxMask := uint64(1<<idxBits - 1)
+	idxBytes := int((idxBits + 7) / 8)
+
+	b := make([]byte, n)
+	for i := 0; i < n; {
+		if idx := secureUint(idxBytes) & idxMask; idx < uint64(len(alphabet)) {
+			b[i] = alphabet[idx]
+			i++
+		}
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,35 @@
+package randtool
+
+import (
+	"strings"
+	"testing"
+)
+
+func isSubsetOf(s, alphabet string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune(alphabet, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// TestGenStrSecureWithAlphabetOver256Chars guards against the idxMask
+// truncating to a byte for alphabets needing more than 8 index bits, which
+// silently made every character beyond the 256th undrawable.
+func TestGenStrSecureWithAlphabetOver256Chars(t *testing.T) {
+	alphabet := strings.Repeat("a", 257) + "Z" // 258 chars; marker at index 257
+	found := false
+	for i := 0; i < 2000 && !found; i++ {
+		s, err := GenStrSecureWithAlphabet(32, alphabet)
+		if err != nil {
+			t.Fatalf("GenStrSecureWithAlphabet: %v", err)
+		}
+		if strings.ContainsRune(s, 'Z') {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("marker character at alphabet index 257 was never drawn in 2000 attempts")
+	}
+}
@@ -0,0 +1,30 @@
+package randtool
+
+import "testing"
+
+// NewDeterministic returns a Rand seeded deterministically from seed, with
+// no crypto/rand or wall-clock entropy mixed in. Prefer New or NewFromSeed
+// for anything other than tests: NewDeterministic exists so tests can
+// produce byte-identical output (e.g. for golden files) across runs.
+func NewDeterministic(seed int64) *Rand {
+	return NewFromSeed(seed)
+}
+
+// SetTestSeed points the package-level Gen* helpers at a Rand seeded
+// deterministically from seed for the duration of t, restoring the previous
+// default once t completes. It leaves Rand instances production callers
+// constructed with New or NewFromSeed untouched.
+//
+// SetTestSeed is not safe for concurrent use: it swaps the package-wide
+// default instance with no synchronization, so it must not race with
+// parallel subtests or any other goroutine calling the package-level Gen*
+// helpers while it runs.
+func SetTestSeed(t testing.TB, seed int64) {
+	t.Helper()
+
+	previous := defaultRand
+	defaultRand = NewDeterministic(seed)
+	t.Cleanup(func() {
+		defaultRand = previous
+	})
+}
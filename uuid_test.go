@@ -0,0 +1,48 @@
+package randtool
+
+import "testing"
+
+func TestGenUUIDv4VersionAndVariant(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		b := GenUUIDv4Bytes()
+		if version := b[6] >> 4; version != 0x4 {
+			t.Fatalf("byte 6 high nibble = %x, want 4", version)
+		}
+		if variant := b[8] >> 6; variant != 0x2 {
+			t.Fatalf("byte 8 top two bits = %b, want 10", variant)
+		}
+	}
+}
+
+func TestGenUUIDv7VersionAndVariant(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		b := GenUUIDv7Bytes()
+		if version := b[6] >> 4; version != 0x7 {
+			t.Fatalf("byte 6 high nibble = %x, want 7", version)
+		}
+		if variant := b[8] >> 6; variant != 0x2 {
+			t.Fatalf("byte 8 top two bits = %b, want 10", variant)
+		}
+	}
+}
+
+func TestGenUUIDv4Format(t *testing.T) {
+	s := GenUUIDv4()
+	if len(s) != 36 {
+		t.Fatalf("GenUUIDv4() length = %d, want 36: %q", len(s), s)
+	}
+	for _, i := range []int{8, 13, 18, 23} {
+		if s[i] != '-' {
+			t.Fatalf("GenUUIDv4() = %q, expected '-' at index %d", s, i)
+		}
+	}
+}
+
+func TestRandUUIDv4IsReproducible(t *testing.T) {
+	a := NewDeterministic(99)
+	b := NewDeterministic(99)
+
+	if a.UUIDv4() != b.UUIDv4() {
+		t.Fatalf("Rand.UUIDv4 not reproducible for the same seed")
+	}
+}